@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/go-backend/handlers"
+)
+
+// RegisterAuthRoutes mounts the password-based auth endpoints.
+func RegisterAuthRoutes(r *gin.Engine, h *handlers.AuthHandler) {
+	auth := r.Group("/auth")
+	{
+		auth.POST("/register", h.Register)
+		auth.POST("/login", h.Login)
+		auth.POST("/refresh", h.Refresh)
+	}
+}
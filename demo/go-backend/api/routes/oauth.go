@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	oauthhandler "github.com/example/go-backend/handlers/oauth"
+)
+
+// RegisterOAuthRoutes mounts the OAuth2/SSO login flow endpoints.
+func RegisterOAuthRoutes(r *gin.Engine) {
+	oauthGroup := r.Group("/oauth")
+	{
+		oauthGroup.GET("/login", oauthhandler.Login)
+		oauthGroup.GET("/callback", oauthhandler.Callback)
+	}
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/go-backend/handlers"
+	"github.com/example/go-backend/middleware"
+)
+
+// RegisterProductRoutes mounts the authenticated product resource endpoints.
+func RegisterProductRoutes(r *gin.Engine, h *handlers.ProductHandler) {
+	api := r.Group("/api/v1")
+	api.Use(middleware.AuthMiddleware())
+	{
+		api.GET("/products", h.GetProducts)
+		api.POST("/products", middleware.RequireScope("users:write"), h.CreateProduct)
+	}
+}
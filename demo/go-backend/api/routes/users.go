@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/go-backend/handlers"
+	"github.com/example/go-backend/middleware"
+)
+
+// RegisterUserRoutes mounts the authenticated user resource endpoints.
+func RegisterUserRoutes(r *gin.Engine, h *handlers.UserHandler) {
+	api := r.Group("/api/v1")
+	api.Use(middleware.AuthMiddleware())
+	{
+		api.GET("/users", h.GetUsers)
+		api.GET("/users/:id", h.GetUser)
+		api.POST("/users", middleware.RequireScope("users:write"), h.CreateUser)
+		api.PUT("/users/:id", middleware.RequireScope("users:write"), h.UpdateUser)
+		api.DELETE("/users/:id", middleware.RequireScope("users:write"), h.DeleteUser)
+	}
+}
@@ -0,0 +1,131 @@
+// Package api assembles the HTTP server: middleware, dependency wiring, and
+// route registration.
+package api
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+
+	"github.com/example/go-backend/api/routes"
+	"github.com/example/go-backend/dependencies"
+	"github.com/example/go-backend/handlers"
+	oauthhandler "github.com/example/go-backend/handlers/oauth"
+	"github.com/example/go-backend/jobs"
+	"github.com/example/go-backend/middleware"
+	"github.com/example/go-backend/repository"
+	"github.com/example/go-backend/services"
+)
+
+// shutdownTimeout bounds how long Run waits for in-flight requests and jobs
+// to drain once a shutdown signal is received.
+const shutdownTimeout = 30 * time.Second
+
+// Server wires middleware and routes onto a gin engine.
+type Server struct {
+	deps      *dependencies.Dependencies
+	engine    *gin.Engine
+	scheduler *jobs.Scheduler
+}
+
+// NewServer constructs a Server backed by deps.
+func NewServer(deps *dependencies.Dependencies) *Server {
+	return &Server{deps: deps, engine: gin.Default()}
+}
+
+// Setup wires middleware, services, and route groups onto the engine.
+func (s *Server) Setup() *Server {
+	s.engine.Use(middleware.LoggingMiddleware(s.deps))
+	s.engine.Use(middleware.RecoveryMiddleware(s.deps))
+
+	s.engine.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	userRepo := repository.NewUserRepository(s.deps.DB)
+	tokenRepo := repository.NewRefreshTokenRepository(s.deps.DB)
+	productRepo := repository.NewProductRepository(s.deps.DB)
+
+	s.scheduler = jobs.NewScheduler(s.deps.Logger, s.deps.Config.JobWorkerCount)
+
+	userSvc := services.NewUserService(userRepo, s.scheduler)
+	authSvc := services.NewAuthService(userRepo, tokenRepo)
+
+	userHandler := handlers.NewUserHandler(userSvc)
+	authHandler := handlers.NewAuthHandler(authSvc)
+	productHandler := handlers.NewProductHandler(productRepo)
+
+	oauthhandler.InitRedis(s.deps.Redis)
+	oauthhandler.InitUserRepository(userRepo)
+
+	s.scheduleRecurringJobs(userSvc)
+	s.scheduler.Start()
+
+	routes.RegisterAuthRoutes(s.engine, authHandler)
+	routes.RegisterOAuthRoutes(s.engine)
+	routes.RegisterUserRoutes(s.engine, userHandler)
+	routes.RegisterProductRoutes(s.engine, productHandler)
+
+	return s
+}
+
+// scheduleRecurringJobs wires up the cron-style jobs configured in the
+// schedule YAML. Only entries named "process_users" are scheduled; entries
+// naming any other job are ignored. A missing or unreadable file just means
+// no recurring jobs.
+func (s *Server) scheduleRecurringJobs(userSvc *services.UserService) {
+	schedules, err := jobs.LoadSchedules(s.deps.Config.JobSchedulePath)
+	if err != nil {
+		s.deps.Logger.Info("no job schedule file found, skipping recurring jobs", zap.Error(err))
+		return
+	}
+
+	for _, sched := range schedules {
+		if sched.Name != "process_users" {
+			continue
+		}
+		if err := s.scheduler.Every(sched.Cron, userSvc.ProcessUsersJob()); err != nil {
+			s.deps.Logger.Warn("failed to schedule job",
+				zap.String("job", sched.Name),
+				zap.String("cron", sched.Cron),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// Run starts the HTTP server on addr and blocks until an interrupt/terminate
+// signal arrives, then drains in-flight requests and jobs before returning.
+func (s *Server) Run(addr string) error {
+	httpServer := &http.Server{Addr: addr, Handler: s.engine}
+
+	errCh := make(chan error, 1)
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-sigCh:
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	return s.scheduler.Shutdown(ctx)
+}
@@ -0,0 +1,160 @@
+// Package auth issues and validates the JWTs used to authenticate API
+// requests, independent of how those tokens end up in the caller's hands
+// (password login, OAuth, refresh).
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// AccessTokenTTL is the lifetime of a newly issued access token.
+const AccessTokenTTL = 15 * time.Minute
+
+// RefreshTokenTTL is the lifetime of a newly issued refresh token.
+const RefreshTokenTTL = 7 * 24 * time.Hour
+
+var signingKey = []byte(signingKeyFromEnv())
+
+// rsaPrivateKey/rsaPublicKey are populated from JWT_RSA_PRIVATE_KEY /
+// JWT_RSA_PUBLIC_KEY when set. When a private key is configured, access
+// tokens are signed RS256 instead of HS256; ParseAccessToken always accepts
+// HS256 tokens, and RS256 tokens too when an RSA public key is available.
+var (
+	rsaPrivateKey = rsaPrivateKeyFromEnv()
+	rsaPublicKey  = rsaPublicKeyFromEnv()
+)
+
+func signingKeyFromEnv() string {
+	if key := os.Getenv("JWT_SIGNING_KEY"); key != "" {
+		return key
+	}
+	return "dev-secret-change-me"
+}
+
+func rsaPrivateKeyFromEnv() *rsa.PrivateKey {
+	pem := os.Getenv("JWT_RSA_PRIVATE_KEY")
+	if pem == "" {
+		return nil
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(pem))
+	if err != nil {
+		return nil
+	}
+	return key
+}
+
+func rsaPublicKeyFromEnv() *rsa.PublicKey {
+	if rsaPrivateKey != nil {
+		return &rsaPrivateKey.PublicKey
+	}
+	pem := os.Getenv("JWT_RSA_PUBLIC_KEY")
+	if pem == "" {
+		return nil
+	}
+	key, err := jwt.ParseRSAPublicKeyFromPEM([]byte(pem))
+	if err != nil {
+		return nil
+	}
+	return key
+}
+
+// Claims is the claim set embedded in access tokens.
+type Claims struct {
+	UserID uint     `json:"user_id"`
+	Scopes []string `json:"scopes"`
+	jwt.RegisteredClaims
+}
+
+// GenerateAccessToken issues a signed access token for userID, scoped to
+// scopes, and returns the token string along with its expiry. It signs
+// RS256 when JWT_RSA_PRIVATE_KEY is configured, HS256 otherwise.
+func GenerateAccessToken(userID uint, scopes []string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(AccessTokenTTL)
+
+	claims := Claims{
+		UserID: userID,
+		Scopes: scopes,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	method, key := jwt.SigningMethodHS256, interface{}(signingKey)
+	if rsaPrivateKey != nil {
+		method, key = jwt.SigningMethodRS256, rsaPrivateKey
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	signed, err := token.SignedString(key)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign access token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+// ParseAccessToken validates tokenString and returns its claims. It accepts
+// HS256 tokens verified against JWT_SIGNING_KEY, or RS256 tokens verified
+// against JWT_RSA_PUBLIC_KEY (or the public half of JWT_RSA_PRIVATE_KEY)
+// when one of those is configured.
+func ParseAccessToken(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		switch t.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			return signingKey, nil
+		case *jwt.SigningMethodRSA:
+			if rsaPublicKey == nil {
+				return nil, fmt.Errorf("RS256 token rejected: no RSA public key configured")
+			}
+			return rsaPublicKey, nil
+		default:
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parse access token: %w", err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("invalid access token")
+	}
+	return claims, nil
+}
+
+// NewRefreshToken generates a random opaque refresh token suitable for
+// persisting and later looking up in the repository layer.
+func NewRefreshToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate refresh token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// HashRefreshToken returns the SHA-256 hex digest of a refresh token, which
+// is what gets persisted and looked up instead of the raw token value, so a
+// leaked database row can't be replayed as a live session.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// HasScope reports whether scopes contains the requested scope.
+func HasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if strings.EqualFold(s, scope) {
+			return true
+		}
+	}
+	return false
+}
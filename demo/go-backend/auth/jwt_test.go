@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateAndParseAccessToken(t *testing.T) {
+	token, expiresAt, err := GenerateAccessToken(42, []string{"users:read"})
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(AccessTokenTTL), expiresAt, time.Second)
+
+	claims, err := ParseAccessToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(42), claims.UserID)
+	assert.Equal(t, []string{"users:read"}, claims.Scopes)
+}
+
+func TestParseAccessTokenRejectsTampering(t *testing.T) {
+	token, _, err := GenerateAccessToken(1, nil)
+	assert.NoError(t, err)
+
+	_, err = ParseAccessToken(token + "tampered")
+	assert.Error(t, err)
+}
+
+func TestNewRefreshTokenIsUnique(t *testing.T) {
+	a, err := NewRefreshToken()
+	assert.NoError(t, err)
+	b, err := NewRefreshToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, a, b)
+}
+
+func TestHashRefreshTokenIsDeterministicAndOneWay(t *testing.T) {
+	token, err := NewRefreshToken()
+	assert.NoError(t, err)
+
+	hash := HashRefreshToken(token)
+	assert.Equal(t, hash, HashRefreshToken(token))
+	assert.NotEqual(t, token, hash)
+}
+
+func TestGenerateAndParseAccessTokenRS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	rsaPrivateKey = key
+	rsaPublicKey = &key.PublicKey
+	t.Cleanup(func() {
+		rsaPrivateKey = nil
+		rsaPublicKey = nil
+	})
+
+	token, _, err := GenerateAccessToken(7, []string{"users:write"})
+	assert.NoError(t, err)
+
+	claims, err := ParseAccessToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), claims.UserID)
+}
+
+func TestParseAccessTokenRejectsRS256WithoutConfiguredPublicKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	rsaPrivateKey = key
+	rsaPublicKey = &key.PublicKey
+	token, _, err := GenerateAccessToken(7, nil)
+	assert.NoError(t, err)
+
+	rsaPrivateKey = nil
+	rsaPublicKey = nil
+
+	_, err = ParseAccessToken(token)
+	assert.Error(t, err)
+}
+
+func TestHasScope(t *testing.T) {
+	scopes := []string{"users:read", "users:write"}
+	assert.True(t, HasScope(scopes, "users:Read"))
+	assert.False(t, HasScope(scopes, "admin"))
+}
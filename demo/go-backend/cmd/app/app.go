@@ -0,0 +1,74 @@
+// Package app builds the app's dependencies and runs the HTTP server, so
+// that main is a one-line entry point.
+package app
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/eko/gocache/v2/store"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/example/go-backend/api"
+	"github.com/example/go-backend/config"
+	"github.com/example/go-backend/dependencies"
+	oauthhandler "github.com/example/go-backend/handlers/oauth"
+	"github.com/example/go-backend/models"
+	"github.com/example/go-backend/repository"
+	"github.com/example/go-backend/validators"
+)
+
+// Run builds the app's dependencies and blocks serving HTTP traffic.
+func Run() error {
+	cfg := config.Load()
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return fmt.Errorf("build logger: %w", err)
+	}
+	defer logger.Sync()
+
+	if err := validators.RegisterCustom("price_positive", validators.PricePositive); err != nil {
+		return fmt.Errorf("register validators: %w", err)
+	}
+
+	db, err := gorm.Open(sqlite.Open(cfg.DatabaseDSN), &gorm.Config{})
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&models.User{}, &models.RefreshToken{}, &models.Product{}); err != nil {
+		return fmt.Errorf("migrate database: %w", err)
+	}
+
+	redisClient := redis.NewClient(&redis.Options{Addr: cfg.RedisAddr})
+
+	ristrettoCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1e7,
+		MaxCost:     1 << 30,
+		BufferItems: 64,
+	})
+	if err != nil {
+		return fmt.Errorf("build ristretto cache: %w", err)
+	}
+	repository.InitCache(store.NewRistretto(ristrettoCache, nil))
+
+	deps := dependencies.New(logger, db, redisClient, cfg)
+
+	oauthhandler.InitProvider(&oauth2.Config{
+		ClientID:     cfg.GithubClientID,
+		ClientSecret: cfg.GithubClientSecret,
+		Endpoint:     github.Endpoint,
+		Scopes:       []string{"user:email"},
+		RedirectURL:  cfg.GithubRedirectURL,
+	})
+
+	server := api.NewServer(deps).Setup()
+
+	return server.Run(cfg.Addr)
+}
@@ -0,0 +1,53 @@
+// Package config centralizes the environment-sourced configuration used to
+// bootstrap the app.
+package config
+
+import (
+	"os"
+	"strconv"
+)
+
+// Config holds runtime configuration sourced from the environment.
+type Config struct {
+	Addr        string
+	DatabaseDSN string
+	RedisAddr   string
+
+	GithubClientID     string
+	GithubClientSecret string
+	GithubRedirectURL  string
+
+	JobSchedulePath string
+	JobWorkerCount  int
+}
+
+// Load reads configuration from environment variables, applying defaults.
+func Load() *Config {
+	return &Config{
+		Addr:        envOrDefault("APP_ADDR", ":8080"),
+		DatabaseDSN: envOrDefault("DATABASE_DSN", "go-backend.db"),
+		RedisAddr:   envOrDefault("REDIS_ADDR", "localhost:6379"),
+
+		GithubClientID:     os.Getenv("GITHUB_CLIENT_ID"),
+		GithubClientSecret: os.Getenv("GITHUB_CLIENT_SECRET"),
+		GithubRedirectURL:  os.Getenv("GITHUB_REDIRECT_URL"),
+
+		JobSchedulePath: envOrDefault("JOB_SCHEDULE_PATH", "jobs.yaml"),
+		JobWorkerCount:  intEnvOrDefault("JOB_WORKER_COUNT", 4),
+	}
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func intEnvOrDefault(key string, fallback int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return v
+}
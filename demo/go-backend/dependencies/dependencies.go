@@ -0,0 +1,25 @@
+// Package dependencies holds the shared, process-wide collaborators (logger,
+// database, Redis client, config) that are threaded through the app instead of being
+// reached for as package-level globals.
+package dependencies
+
+import (
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/example/go-backend/config"
+)
+
+// Dependencies bundles the collaborators handlers and middleware need.
+type Dependencies struct {
+	Logger *zap.Logger
+	DB     *gorm.DB
+	Redis  *redis.Client
+	Config *config.Config
+}
+
+// New constructs a Dependencies from its component parts.
+func New(logger *zap.Logger, db *gorm.DB, redisClient *redis.Client, cfg *config.Config) *Dependencies {
+	return &Dependencies{Logger: logger, DB: db, Redis: redisClient, Config: cfg}
+}
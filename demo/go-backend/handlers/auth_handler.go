@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/example/go-backend/httperror"
+	"github.com/example/go-backend/models"
+	"github.com/example/go-backend/services"
+)
+
+// tokenResponse is the payload returned by the token-issuing endpoints.
+type tokenResponse struct {
+	Access    string `json:"access"`
+	Refresh   string `json:"refresh"`
+	ExpiresIn int64  `json:"expires_in"`
+}
+
+// AuthHandler exposes the registration/login/refresh HTTP handlers.
+type AuthHandler struct {
+	svc *services.AuthService
+}
+
+// NewAuthHandler creates a new AuthHandler backed by svc
+func NewAuthHandler(svc *services.AuthService) *AuthHandler {
+	return &AuthHandler{svc: svc}
+}
+
+// Register creates a new user account with a hashed password
+func (h *AuthHandler) Register(c *gin.Context) {
+	var input models.RegisterInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tokens, err := h.svc.Register(&input)
+	if err != nil {
+		httperror.Respond(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTokenResponse(tokens))
+}
+
+// Login authenticates a user by email/password and issues access and refresh tokens
+func (h *AuthHandler) Login(c *gin.Context) {
+	var input models.LoginInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tokens, err := h.svc.Login(&input)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCredentials) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
+			return
+		}
+		httperror.Respond(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTokenResponse(tokens))
+}
+
+// Refresh exchanges a valid, non-revoked refresh token for a new token pair
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var input models.RefreshInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tokens, err := h.svc.Refresh(input.RefreshToken)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCredentials) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid refresh token"})
+			return
+		}
+		httperror.Respond(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTokenResponse(tokens))
+}
+
+func toTokenResponse(tokens *services.TokenPair) tokenResponse {
+	return tokenResponse{
+		Access:    tokens.Access,
+		Refresh:   tokens.Refresh,
+		ExpiresIn: tokens.ExpiresIn,
+	}
+}
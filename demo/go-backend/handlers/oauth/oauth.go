@@ -0,0 +1,216 @@
+// Package oauth implements the OAuth2/SSO login flow used to provision and
+// authenticate users via a third-party identity provider (GitHub).
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/oauth2"
+
+	"github.com/example/go-backend/auth"
+	"github.com/example/go-backend/httperror"
+	"github.com/example/go-backend/repository"
+)
+
+// stateTTL bounds how long an issued state value remains valid.
+const stateTTL = 5 * time.Minute
+
+const stateCookieName = "oauth_state"
+
+var (
+	redisClient *redis.Client
+	provider    *oauth2.Config
+	userRepo    repository.UserRepository
+)
+
+// InitRedis wires the Redis client used to store pending OAuth state.
+func InitRedis(client *redis.Client) {
+	redisClient = client
+}
+
+// InitProvider wires the oauth2 config for the identity provider (e.g. GitHub).
+func InitProvider(cfg *oauth2.Config) {
+	provider = cfg
+}
+
+// InitUserRepository wires the repository used to look up and provision users.
+func InitUserRepository(repo repository.UserRepository) {
+	userRepo = repo
+}
+
+// githubUser is the profile info we provision a local account from. Any
+// "email" value decoded from /user is overwritten by fetchGithubUser with
+// the verified primary address from /user/emails.
+type githubUser struct {
+	Email string
+	Name  string `json:"login"`
+}
+
+// githubEmail is a single entry from GET /user/emails.
+type githubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+// Login generates a state value, stashes it in Redis behind a cookie, and
+// redirects the caller to the provider's consent screen.
+func Login(c *gin.Context) {
+	state, err := auth.NewRefreshToken()
+	if err != nil {
+		httperror.Respond(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	cookieID := uuid.NewString()
+	key := "oauth_state:" + cookieID
+
+	if err := redisClient.Set(c.Request.Context(), key, state, stateTTL).Err(); err != nil {
+		httperror.Respond(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.SetCookie(stateCookieName, cookieID, int(stateTTL.Seconds()), "/", "", false, true)
+	c.Redirect(http.StatusFound, provider.AuthCodeURL(state))
+}
+
+// Callback validates the returned state against Redis, exchanges the code
+// for a token, upserts the local user, and mints a local JWT.
+func Callback(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	cookieID, err := c.Cookie(stateCookieName)
+	if err != nil {
+		c.Redirect(http.StatusFound, "/error?message=missing_state_cookie")
+		return
+	}
+
+	key := "oauth_state:" + cookieID
+	expectedState, err := redisClient.Get(ctx, key).Result()
+	if err != nil {
+		c.Redirect(http.StatusFound, "/error?message=state_expired")
+		return
+	}
+	redisClient.Del(ctx, key)
+
+	if expectedState != c.Query("state") {
+		c.Redirect(http.StatusFound, "/error?message=state_mismatch")
+		return
+	}
+
+	token, err := provider.Exchange(ctx, c.Query("code"))
+	if err != nil {
+		c.Redirect(http.StatusFound, "/error?message=exchange_failed")
+		return
+	}
+
+	profile, err := fetchGithubUser(ctx, token)
+	if err != nil {
+		c.Redirect(http.StatusFound, "/error?message=profile_fetch_failed")
+		return
+	}
+
+	if profile.Email == "" {
+		c.Redirect(http.StatusFound, "/error?message=no_verified_email")
+		return
+	}
+
+	user, err := userRepo.GetUserByEmail(profile.Email)
+	if err != nil {
+		randomHash, genErr := auth.NewRefreshToken()
+		if genErr != nil {
+			c.Redirect(http.StatusFound, "/error?message=provisioning_failed")
+			return
+		}
+		user, err = userRepo.CreateUserWithCredentials(profile.Name, profile.Email, randomHash, []string{"users:read"})
+		if err != nil {
+			c.Redirect(http.StatusFound, "/error?message=provisioning_failed")
+			return
+		}
+	}
+
+	access, _, err := auth.GenerateAccessToken(user.ID, user.ScopeList())
+	if err != nil {
+		c.Redirect(http.StatusFound, "/error?message=token_failed")
+		return
+	}
+
+	c.Redirect(http.StatusFound, "/login?redirect_token="+access)
+}
+
+// fetchGithubUser exchanges an oauth2 token for the caller's GitHub profile
+// and their verified primary email. The public /user "email" field is null
+// for any account that hasn't opted its email into its public profile
+// (GitHub's default), so it can't be used as an identity key: two different
+// users with no public email would both resolve to the same empty string.
+// Fetching /user/emails and requiring a verified, primary entry is what the
+// "user:email" scope is actually for.
+func fetchGithubUser(ctx context.Context, token *oauth2.Token) (*githubUser, error) {
+	client := provider.Client(ctx, token)
+
+	resp, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		return nil, fmt.Errorf("fetch github user: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read github user response: %w", err)
+	}
+
+	var profile githubUser
+	if err := json.Unmarshal(body, &profile); err != nil {
+		return nil, fmt.Errorf("decode github user response: %w", err)
+	}
+
+	email, err := fetchGithubVerifiedEmail(client)
+	if err != nil {
+		return nil, err
+	}
+	profile.Email = email
+
+	return &profile, nil
+}
+
+// fetchGithubVerifiedEmail returns the caller's verified primary email, or
+// an empty string if they have none, which Callback treats as a login
+// failure rather than a valid identity.
+func fetchGithubVerifiedEmail(client *http.Client) (string, error) {
+	resp, err := client.Get("https://api.github.com/user/emails")
+	if err != nil {
+		return "", fmt.Errorf("fetch github emails: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read github emails response: %w", err)
+	}
+
+	var emails []githubEmail
+	if err := json.Unmarshal(body, &emails); err != nil {
+		return "", fmt.Errorf("decode github emails response: %w", err)
+	}
+
+	return selectVerifiedEmail(emails), nil
+}
+
+// selectVerifiedEmail picks the verified primary address out of a GitHub
+// /user/emails response, or "" if there isn't one.
+func selectVerifiedEmail(emails []githubEmail) string {
+	for _, e := range emails {
+		if e.Primary && e.Verified && e.Email != "" {
+			return e.Email
+		}
+	}
+	return ""
+}
@@ -0,0 +1,30 @@
+package oauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectVerifiedEmailPrefersVerifiedPrimary(t *testing.T) {
+	emails := []githubEmail{
+		{Email: "secondary@example.com", Primary: false, Verified: true},
+		{Email: "unverified@example.com", Primary: true, Verified: false},
+		{Email: "primary@example.com", Primary: true, Verified: true},
+	}
+
+	assert.Equal(t, "primary@example.com", selectVerifiedEmail(emails))
+}
+
+func TestSelectVerifiedEmailNoneQualifies(t *testing.T) {
+	emails := []githubEmail{
+		{Email: "unverified@example.com", Primary: true, Verified: false},
+		{Email: "nonprimary@example.com", Primary: false, Verified: true},
+	}
+
+	assert.Empty(t, selectVerifiedEmail(emails))
+}
+
+func TestSelectVerifiedEmailEmptyList(t *testing.T) {
+	assert.Empty(t, selectVerifiedEmail(nil))
+}
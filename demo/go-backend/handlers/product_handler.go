@@ -4,21 +4,53 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/example/go-backend/httperror"
+	"github.com/example/go-backend/models"
 	"github.com/example/go-backend/repository"
+	"github.com/example/go-backend/types"
+	"github.com/example/go-backend/validators"
 )
 
+// ProductHandler exposes the product resource's HTTP handlers.
+type ProductHandler struct {
+	repo repository.ProductRepository
+}
+
+// NewProductHandler creates a new ProductHandler backed by repo
+func NewProductHandler(repo repository.ProductRepository) *ProductHandler {
+	return &ProductHandler{repo: repo}
+}
+
 // GetProducts returns all products
-func GetProducts(c *gin.Context) {
-	products, err := repository.GetAllProducts()
+func (h *ProductHandler) GetProducts(c *gin.Context) {
+	products, err := h.repo.GetAllProducts()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusInternalServerError, err)
 		return
 	}
 	c.JSON(http.StatusOK, products)
 }
 
 // CreateProduct creates a new product
-func CreateProduct(c *gin.Context) {
-	// Implementation
-	c.JSON(http.StatusCreated, gin.H{"message": "created"})
+func (h *ProductHandler) CreateProduct(c *gin.Context) {
+	var req types.CreateProductRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{Success: false, Code: "invalid_body", Message: err.Error()})
+		return
+	}
+
+	if errResp := validators.ValidateStruct(req); errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
+		return
+	}
+
+	product, err := h.repo.CreateProduct(&models.CreateProductInput{Name: req.Name, Price: req.Price})
+	if err != nil {
+		httperror.Respond(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, product)
 }
@@ -2,12 +2,15 @@ package handlers
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/example/go-backend/httperror"
 	"github.com/example/go-backend/models"
-	"github.com/example/go-backend/repository"
+	"github.com/example/go-backend/services"
+	"github.com/example/go-backend/types"
+	"github.com/example/go-backend/validators"
 )
 
 // ErrUserNotFound is returned when a user is not found
@@ -16,78 +19,98 @@ var ErrUserNotFound = errors.New("user not found")
 // ErrInvalidInput is returned when input validation fails
 var ErrInvalidInput = errors.New("invalid input")
 
+// UserHandler exposes the user resource's HTTP handlers.
+type UserHandler struct {
+	svc *services.UserService
+}
+
+// NewUserHandler creates a new UserHandler backed by svc
+func NewUserHandler(svc *services.UserService) *UserHandler {
+	return &UserHandler{svc: svc}
+}
+
 // GetUsers returns all users
-func GetUsers(c *gin.Context) {
-	users, err := repository.GetAllUsers()
+func (h *UserHandler) GetUsers(c *gin.Context) {
+	users, err := h.svc.ListUsers()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusInternalServerError, err)
 		return
 	}
 	c.JSON(http.StatusOK, users)
 }
 
 // GetUser returns a single user by ID
-func GetUser(c *gin.Context) {
+func (h *UserHandler) GetUser(c *gin.Context) {
 	id := c.Param("id")
-	
-	user, err := repository.GetUserByID(id)
+
+	user, err := h.svc.GetUser(id)
 	if err != nil {
 		if errors.Is(err, ErrUserNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusInternalServerError, err)
 		return
 	}
-	
+
 	c.JSON(http.StatusOK, user)
 }
 
 // CreateUser creates a new user
-func CreateUser(c *gin.Context) {
-	var input models.CreateUserInput
-	
-	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Errorf("invalid input: %w", err).Error()})
+func (h *UserHandler) CreateUser(c *gin.Context) {
+	var req types.CreateUserRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{Success: false, Code: "invalid_body", Message: err.Error()})
+		return
+	}
+
+	if errResp := validators.ValidateStruct(req); errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
 		return
 	}
-	
-	user, err := repository.CreateUser(&input)
+
+	user, err := h.svc.CreateUser(&models.CreateUserInput{Name: req.Name, Email: req.Email})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusInternalServerError, err)
 		return
 	}
-	
+
 	c.JSON(http.StatusCreated, user)
 }
 
 // UpdateUser updates an existing user
-func UpdateUser(c *gin.Context) {
+func (h *UserHandler) UpdateUser(c *gin.Context) {
 	id := c.Param("id")
-	var input models.UpdateUserInput
-	
-	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	var req types.UpdateUserRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{Success: false, Code: "invalid_body", Message: err.Error()})
+		return
+	}
+
+	if errResp := validators.ValidateStruct(req); errResp != nil {
+		c.JSON(http.StatusBadRequest, errResp)
 		return
 	}
-	
-	user, err := repository.UpdateUser(id, &input)
+
+	user, err := h.svc.UpdateUser(id, &models.UpdateUserInput{Name: req.Name, Email: req.Email})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusInternalServerError, err)
 		return
 	}
-	
+
 	c.JSON(http.StatusOK, user)
 }
 
 // DeleteUser deletes a user
-func DeleteUser(c *gin.Context) {
+func (h *UserHandler) DeleteUser(c *gin.Context) {
 	id := c.Param("id")
-	
-	if err := repository.DeleteUser(id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+
+	if err := h.svc.DeleteUser(id); err != nil {
+		httperror.Respond(c, http.StatusInternalServerError, err)
 		return
 	}
-	
+
 	c.JSON(http.StatusNoContent, nil)
 }
@@ -7,43 +7,108 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/example/go-backend/jobs"
+	"github.com/example/go-backend/models"
+	"github.com/example/go-backend/services"
 )
 
+// fakeUserRepository is an in-memory repository.UserRepository used to
+// exercise handlers without a real database.
+type fakeUserRepository struct {
+	users []models.User
+}
+
+func (f *fakeUserRepository) GetAllUsers() ([]models.User, error) {
+	return f.users, nil
+}
+
+func (f *fakeUserRepository) GetUserByID(id string) (*models.User, error) {
+	for _, u := range f.users {
+		if id == "1" && u.ID == 1 {
+			return &u, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+func (f *fakeUserRepository) GetUserByEmail(email string) (*models.User, error) {
+	for _, u := range f.users {
+		if u.Email == email {
+			return &u, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+func (f *fakeUserRepository) CreateUser(input *models.CreateUserInput) (*models.User, error) {
+	user := models.User{ID: uint(len(f.users) + 1), Name: input.Name, Email: input.Email}
+	f.users = append(f.users, user)
+	return &user, nil
+}
+
+func (f *fakeUserRepository) CreateUserWithCredentials(name, email, passwordHash string, scopes []string) (*models.User, error) {
+	user := models.User{ID: uint(len(f.users) + 1), Name: name, Email: email, PasswordHash: passwordHash}
+	f.users = append(f.users, user)
+	return &user, nil
+}
+
+func (f *fakeUserRepository) UpdateUser(id string, input *models.UpdateUserInput) (*models.User, error) {
+	return f.GetUserByID(id)
+}
+
+func (f *fakeUserRepository) DeleteUser(id string) error {
+	return nil
+}
+
+func (f *fakeUserRepository) GetUsersByRole(role string) ([]models.User, error) {
+	return f.users, nil
+}
+
+func newTestUserHandler() *UserHandler {
+	repo := &fakeUserRepository{users: []models.User{{ID: 1, Name: "Ada", Email: "ada@example.com"}}}
+	scheduler := jobs.NewScheduler(zap.NewNop(), 1)
+	return NewUserHandler(services.NewUserService(repo, scheduler))
+}
+
 func TestGetUsers(t *testing.T) {
 	gin.SetMode(gin.TestMode)
-	
+
+	h := newTestUserHandler()
 	router := gin.New()
-	router.GET("/users", GetUsers)
-	
+	router.GET("/users", h.GetUsers)
+
 	req, _ := http.NewRequest("GET", "/users", nil)
 	w := httptest.NewRecorder()
-	
+
 	router.ServeHTTP(w, req)
-	
-	// Note: This will fail without DB setup, but tests the structure
-	assert.NotNil(t, w.Body)
+
+	assert.Equal(t, http.StatusOK, w.Code)
 }
 
 func TestGetUser(t *testing.T) {
 	gin.SetMode(gin.TestMode)
-	
+
+	h := newTestUserHandler()
 	router := gin.New()
-	router.GET("/users/:id", GetUser)
-	
+	router.GET("/users/:id", h.GetUser)
+
 	req, _ := http.NewRequest("GET", "/users/1", nil)
 	w := httptest.NewRecorder()
-	
+
 	router.ServeHTTP(w, req)
-	
-	assert.NotNil(t, w.Body)
+
+	assert.Equal(t, http.StatusOK, w.Code)
 }
 
 func BenchmarkGetUsers(b *testing.B) {
 	gin.SetMode(gin.TestMode)
-	
+
+	h := newTestUserHandler()
 	router := gin.New()
-	router.GET("/users", GetUsers)
-	
+	router.GET("/users", h.GetUsers)
+
 	for i := 0; i < b.N; i++ {
 		req, _ := http.NewRequest("GET", "/users", nil)
 		w := httptest.NewRecorder()
@@ -0,0 +1,26 @@
+// Package httperror provides the one error-response helper every HTTP
+// handler package (handlers, handlers/oauth, ...) shares, so error
+// responses are logged and correlated with a request ID the same way
+// everywhere instead of each package carrying its own copy.
+package httperror
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Respond logs err with the request's logger and request ID, then writes a
+// JSON error response carrying that request ID so the client can correlate
+// it with server-side logs.
+func Respond(c *gin.Context, status int, err error) {
+	requestID, _ := c.Get("request_id")
+
+	if logger, ok := c.MustGet("logger").(*zap.Logger); ok {
+		logger.Error("handler error",
+			zap.Error(err),
+			zap.Any("request_id", requestID),
+		)
+	}
+
+	c.JSON(status, gin.H{"error": err.Error(), "request_id": requestID})
+}
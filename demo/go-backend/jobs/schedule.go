@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ScheduleConfig is a single cron-style recurring job definition loaded from YAML.
+type ScheduleConfig struct {
+	Name string `yaml:"name"`
+	Cron string `yaml:"cron"`
+}
+
+// LoadSchedules reads a list of recurring job schedules from a YAML file.
+func LoadSchedules(path string) ([]ScheduleConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []ScheduleConfig
+	if err := yaml.Unmarshal(data, &schedules); err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSchedulesParsesYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.yaml")
+	contents := "- name: process_users\n  cron: \"0 * * * *\"\n"
+	assert.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+	schedules, err := LoadSchedules(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []ScheduleConfig{{Name: "process_users", Cron: "0 * * * *"}}, schedules)
+}
+
+func TestLoadSchedulesMissingFile(t *testing.T) {
+	_, err := LoadSchedules(filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,129 @@
+// Package jobs provides a bounded worker pool and cron scheduler for
+// background work, so services enqueue named, observable jobs instead of
+// firing ad-hoc goroutines.
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"go.uber.org/zap"
+
+	"github.com/example/go-backend/metrics"
+)
+
+// Job is a unit of background work.
+type Job struct {
+	Name    string
+	Payload interface{}
+	Handler func(ctx context.Context, payload interface{}) error
+}
+
+// Scheduler runs Jobs on a bounded worker pool and supports cron-style
+// recurring schedules on top of it.
+type Scheduler struct {
+	logger *zap.Logger
+	cron   *gocron.Scheduler
+	queue  chan Job
+	wg     sync.WaitGroup
+}
+
+// NewScheduler constructs a Scheduler with concurrency workers draining its queue.
+func NewScheduler(logger *zap.Logger, concurrency int) *Scheduler {
+	s := &Scheduler{
+		logger: logger,
+		cron:   gocron.NewScheduler(time.UTC),
+		queue:  make(chan Job, concurrency*4),
+	}
+
+	for i := 0; i < concurrency; i++ {
+		s.wg.Add(1)
+		go s.worker()
+	}
+
+	return s
+}
+
+// Enqueue submits job to the worker pool for execution as capacity allows.
+func (s *Scheduler) Enqueue(job Job) {
+	s.queue <- job
+}
+
+// Every registers job to run on a recurring cron-style schedule (e.g. "*/5 * * * *").
+func (s *Scheduler) Every(cronExpr string, job Job) error {
+	_, err := s.cron.Cron(cronExpr).Do(func() {
+		s.Enqueue(job)
+	})
+	return err
+}
+
+// Start begins running any registered cron schedules.
+func (s *Scheduler) Start() {
+	s.cron.StartAsync()
+}
+
+// Shutdown stops the cron scheduler and waits for in-flight jobs to drain,
+// or ctx to expire, whichever comes first.
+func (s *Scheduler) Shutdown(ctx context.Context) error {
+	s.cron.Stop()
+	close(s.queue)
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) worker() {
+	defer s.wg.Done()
+
+	for job := range s.queue {
+		s.run(job)
+	}
+}
+
+func (s *Scheduler) run(job Job) {
+	start := time.Now()
+	s.logger.Info("job started", zap.String("job", job.Name))
+
+	defer func() {
+		if r := recover(); r != nil {
+			metrics.JobPanics.WithLabelValues(job.Name).Inc()
+			s.logger.Error("job panicked",
+				zap.String("job", job.Name),
+				zap.Any("panic", r),
+			)
+			return
+		}
+	}()
+
+	err := job.Handler(context.Background(), job.Payload)
+	duration := time.Since(start)
+	metrics.JobDuration.WithLabelValues(job.Name).Observe(duration.Seconds())
+
+	if err != nil {
+		metrics.JobFailures.WithLabelValues(job.Name).Inc()
+		s.logger.Error("job failed",
+			zap.String("job", job.Name),
+			zap.Duration("duration", duration),
+			zap.Error(err),
+		)
+		return
+	}
+
+	metrics.JobSuccesses.WithLabelValues(job.Name).Inc()
+	s.logger.Info("job finished",
+		zap.String("job", job.Name),
+		zap.Duration("duration", duration),
+	)
+}
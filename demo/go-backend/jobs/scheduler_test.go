@@ -0,0 +1,108 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestSchedulerRunsEnqueuedJob(t *testing.T) {
+	s := NewScheduler(zap.NewNop(), 2)
+
+	done := make(chan struct{})
+	s.Enqueue(Job{
+		Name: "test_job",
+		Handler: func(ctx context.Context, _ interface{}) error {
+			close(done)
+			return nil
+		},
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job did not run in time")
+	}
+
+	assert.NoError(t, s.Shutdown(context.Background()))
+}
+
+func TestSchedulerRecoversHandlerPanic(t *testing.T) {
+	s := NewScheduler(zap.NewNop(), 1)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	s.Enqueue(Job{
+		Name: "panicky_job",
+		Handler: func(ctx context.Context, _ interface{}) error {
+			defer wg.Done()
+			panic("boom")
+		},
+	})
+	wg.Wait()
+
+	// The worker must still be alive to run a job queued after the panic.
+	done := make(chan struct{})
+	s.Enqueue(Job{
+		Name: "after_panic_job",
+		Handler: func(ctx context.Context, _ interface{}) error {
+			close(done)
+			return nil
+		},
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not survive the panic")
+	}
+
+	assert.NoError(t, s.Shutdown(context.Background()))
+}
+
+func TestSchedulerShutdownTimesOutOnSlowJob(t *testing.T) {
+	s := NewScheduler(zap.NewNop(), 1)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	s.Enqueue(Job{
+		Name: "slow_job",
+		Handler: func(ctx context.Context, _ interface{}) error {
+			close(started)
+			<-release
+			return nil
+		},
+	})
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := s.Shutdown(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+}
+
+func TestSchedulerEveryAcceptsValidCronExpression(t *testing.T) {
+	s := NewScheduler(zap.NewNop(), 1)
+
+	err := s.Every("*/5 * * * *", Job{Name: "recurring_job", Handler: func(ctx context.Context, _ interface{}) error { return nil }})
+	assert.NoError(t, err)
+
+	s.Start()
+	assert.NoError(t, s.Shutdown(context.Background()))
+}
+
+func TestSchedulerEveryRejectsInvalidCronExpression(t *testing.T) {
+	s := NewScheduler(zap.NewNop(), 1)
+
+	err := s.Every("not a cron expression", Job{Name: "bad_job", Handler: func(ctx context.Context, _ interface{}) error { return nil }})
+	assert.Error(t, err)
+
+	assert.NoError(t, s.Shutdown(context.Background()))
+}
@@ -0,0 +1,45 @@
+// Package metrics exposes the Prometheus collectors the app reports through
+// the /metrics endpoint.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// CacheHits counts cache hits, labeled by cache key prefix (e.g. "user", "users").
+var CacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "cache_hits_total",
+	Help: "Number of cache hits, labeled by cache key prefix.",
+}, []string{"cache"})
+
+// CacheMisses counts cache misses, labeled by cache key prefix.
+var CacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "cache_misses_total",
+	Help: "Number of cache misses, labeled by cache key prefix.",
+}, []string{"cache"})
+
+// JobSuccesses counts background jobs that completed without error, labeled by job name.
+var JobSuccesses = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "job_successes_total",
+	Help: "Number of background jobs that completed without error, labeled by job name.",
+}, []string{"job"})
+
+// JobFailures counts background jobs that returned an error, labeled by job name.
+var JobFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "job_failures_total",
+	Help: "Number of background jobs that returned an error, labeled by job name.",
+}, []string{"job"})
+
+// JobPanics counts background jobs that panicked, labeled by job name.
+var JobPanics = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "job_panics_total",
+	Help: "Number of background jobs that panicked, labeled by job name.",
+}, []string{"job"})
+
+// JobDuration observes background job execution time in seconds, labeled by job name.
+var JobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "job_duration_seconds",
+	Help:    "Background job execution time in seconds, labeled by job name.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"job"})
@@ -5,37 +5,50 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/example/go-backend/auth"
 )
 
-// AuthMiddleware validates JWT tokens
+// AuthMiddleware validates the JWT access token on the Authorization header
+// and populates the gin context with the authenticated user's ID and scopes.
 func AuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
-		
+
 		if authHeader == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing authorization header"})
 			return
 		}
-		
+
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid authorization header"})
 			return
 		}
-		
-		token := parts[1]
-		
-		// Validate token (simplified)
-		if !validateToken(token) {
+
+		claims, err := auth.ParseAccessToken(parts[1])
+		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
 			return
 		}
-		
+
+		c.Set("user_id", claims.UserID)
+		c.Set("scopes", claims.Scopes)
+
 		c.Next()
 	}
 }
 
-func validateToken(token string) bool {
-	// Token validation logic
-	return token != ""
+// RequireScope aborts the request with 403 unless the authenticated user's
+// token carries the given scope. It must run after AuthMiddleware.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scopes, ok := c.Get("scopes")
+		if !ok || !auth.HasScope(scopes.([]string), scope) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "missing required scope: " + scope})
+			return
+		}
+
+		c.Next()
+	}
 }
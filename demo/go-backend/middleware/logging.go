@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/example/go-backend/dependencies"
+)
+
+// requestIDHeader is the response header clients can use to correlate logs.
+const requestIDHeader = "X-Request-ID"
+
+// LoggingMiddleware assigns each request a request ID, echoes it back in the
+// X-Request-ID header, and logs method, path, status, client IP, user agent,
+// bytes written, and latency once the request completes.
+func LoggingMiddleware(deps *dependencies.Dependencies) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := uuid.NewString()
+		c.Set("request_id", requestID)
+		c.Set("logger", deps.Logger)
+		c.Header(requestIDHeader, requestID)
+
+		start := time.Now()
+		c.Next()
+
+		deps.Logger.Info("request completed",
+			zap.String("request_id", requestID),
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Int("status", c.Writer.Status()),
+			zap.String("client_ip", c.ClientIP()),
+			zap.String("user_agent", c.Request.UserAgent()),
+			zap.Int("bytes_written", c.Writer.Size()),
+			zap.Duration("latency", time.Since(start)),
+		)
+	}
+}
+
+// RecoveryMiddleware recovers from panics, logs the stack alongside the
+// request's ID, and returns a 500 carrying that ID so clients can correlate
+// the failure with server-side logs.
+func RecoveryMiddleware(deps *dependencies.Dependencies) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if r := recover(); r != nil {
+				requestID, _ := c.Get("request_id")
+
+				deps.Logger.Error("panic recovered",
+					zap.Any("panic", r),
+					zap.String("request_id", toString(requestID)),
+					zap.Stack("stack"),
+				)
+
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error":      "internal server error",
+					"request_id": requestID,
+				})
+			}
+		}()
+
+		c.Next()
+	}
+}
+
+func toString(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
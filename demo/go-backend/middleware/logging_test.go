@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/example/go-backend/dependencies"
+)
+
+func newTestDeps() *dependencies.Dependencies {
+	return dependencies.New(zap.NewNop(), nil, nil, nil)
+}
+
+func TestLoggingMiddlewareAssignsAndEchoesRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(LoggingMiddleware(newTestDeps()))
+
+	var seenRequestID string
+	router.GET("/ping", func(c *gin.Context) {
+		id, _ := c.Get("request_id")
+		seenRequestID = id.(string)
+		c.Status(http.StatusOK)
+	})
+
+	req, _ := http.NewRequest("GET", "/ping", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.NotEmpty(t, seenRequestID)
+	assert.Equal(t, seenRequestID, w.Header().Get(requestIDHeader))
+}
+
+func TestRecoveryMiddlewareRecoversPanicWithRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(LoggingMiddleware(newTestDeps()))
+	router.Use(RecoveryMiddleware(newTestDeps()))
+
+	router.GET("/boom", func(c *gin.Context) {
+		panic("kaboom")
+	})
+
+	req, _ := http.NewRequest("GET", "/boom", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	requestID := w.Header().Get(requestIDHeader)
+	assert.NotEmpty(t, requestID)
+	assert.Contains(t, w.Body.String(), requestID)
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// RefreshToken represents a persisted, revocable refresh token issued to a
+// user. Token stores the SHA-256 hash of the token value, never the raw
+// token, so a leaked row can't be replayed as a live session.
+type RefreshToken struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	UserID    uint      `json:"user_id" gorm:"not null;index"`
+	Token     string    `json:"-" gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Revoked   bool      `json:"revoked" gorm:"default:false"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for RefreshToken
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
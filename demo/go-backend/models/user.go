@@ -1,17 +1,20 @@
 package models
 
 import (
+	"strings"
 	"time"
 )
 
 // User represents a user in the system
 type User struct {
-	ID        uint      `json:"id" gorm:"primaryKey"`
-	Name      string    `json:"name" gorm:"not null"`
-	Email     string    `json:"email" gorm:"uniqueIndex;not null"`
-	Role      string    `json:"role" gorm:"default:user"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	Name         string    `json:"name" gorm:"not null"`
+	Email        string    `json:"email" gorm:"uniqueIndex;not null"`
+	Role         string    `json:"role" gorm:"default:user"`
+	PasswordHash string    `json:"-" gorm:"not null"`
+	Scopes       string    `json:"-" gorm:"default:''"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
 }
 
 // CreateUserInput represents input for creating a user
@@ -26,7 +29,43 @@ type UpdateUserInput struct {
 	Email string `json:"email" binding:"omitempty,email"`
 }
 
+// RegisterInput represents input for registering a new user with a password
+type RegisterInput struct {
+	Name     string `json:"name" binding:"required"`
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+// LoginInput represents input for logging in with email/password
+type LoginInput struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+// RefreshInput represents input for exchanging a refresh token
+type RefreshInput struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
 // TableName returns the table name for User
 func (User) TableName() string {
 	return "users"
 }
+
+// ScopeList returns the user's scopes as a slice.
+func (u User) ScopeList() []string {
+	if u.Scopes == "" {
+		return nil
+	}
+	return strings.Split(u.Scopes, ",")
+}
+
+// HasScope reports whether the user has been granted the given scope.
+func (u User) HasScope(scope string) bool {
+	for _, s := range u.ScopeList() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/example/go-backend/models"
+	"gorm.io/gorm"
+)
+
+// RefreshTokenRepository defines the persistence operations for refresh
+// tokens. Callers are expected to pass the token's hash, never the raw
+// value; this layer stores and matches whatever it's given verbatim.
+type RefreshTokenRepository interface {
+	CreateRefreshToken(userID uint, tokenHash string, expiresAt time.Time) (*models.RefreshToken, error)
+	GetRefreshToken(tokenHash string) (*models.RefreshToken, error)
+	RevokeRefreshToken(tokenHash string) error
+}
+
+// gormRefreshTokenRepository is a gorm-backed RefreshTokenRepository.
+type gormRefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository constructs a gorm-backed RefreshTokenRepository.
+func NewRefreshTokenRepository(db *gorm.DB) RefreshTokenRepository {
+	return &gormRefreshTokenRepository{db: db}
+}
+
+// CreateRefreshToken persists a new refresh token hash for a user.
+func (r *gormRefreshTokenRepository) CreateRefreshToken(userID uint, tokenHash string, expiresAt time.Time) (*models.RefreshToken, error) {
+	refreshToken := &models.RefreshToken{
+		UserID:    userID,
+		Token:     tokenHash,
+		ExpiresAt: expiresAt,
+	}
+	result := r.db.Create(refreshToken)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return refreshToken, nil
+}
+
+// GetRefreshToken retrieves a non-revoked refresh token by its token hash
+func (r *gormRefreshTokenRepository) GetRefreshToken(tokenHash string) (*models.RefreshToken, error) {
+	var refreshToken models.RefreshToken
+	result := r.db.First(&refreshToken, "token = ? AND revoked = ?", tokenHash, false)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &refreshToken, nil
+}
+
+// RevokeRefreshToken marks a refresh token as revoked by its token hash
+func (r *gormRefreshTokenRepository) RevokeRefreshToken(tokenHash string) error {
+	result := r.db.Model(&models.RefreshToken{}).Where("token = ?", tokenHash).Update("revoked", true)
+	return result.Error
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/eko/gocache/v2/cache"
+	"github.com/eko/gocache/v2/store"
+
+	"github.com/example/go-backend/metrics"
+)
+
+// TTLs are configurable per query; read-mostly list endpoints get a shorter
+// TTL than single-record lookups since they're invalidated more often.
+const (
+	usersListTTL    = 1 * time.Minute
+	userTTL         = 5 * time.Minute
+	productsListTTL = 1 * time.Minute
+	productTTL      = 5 * time.Minute
+)
+
+const usersAllKey = "users:all"
+const productsAllKey = "products:all"
+
+var cacheManager *cache.Cache
+
+// InitCache wires the cache store fronting read-heavy repository queries.
+// Pass a Ristretto store for a single instance, or a Redis store behind the
+// same store.StoreInterface for multi-instance deployments.
+func InitCache(s store.StoreInterface) {
+	cacheManager = cache.New(s)
+}
+
+// cacheGet looks up key and unmarshals it into dest, reporting a hit/miss
+// under the given metric label. Returns false if the cache is disabled, the
+// key is missing, or the value can't be decoded.
+func cacheGet(ctx context.Context, label, key string, dest interface{}) bool {
+	if cacheManager == nil {
+		return false
+	}
+
+	raw, err := cacheManager.Get(ctx, key)
+	if err != nil {
+		metrics.CacheMisses.WithLabelValues(label).Inc()
+		return false
+	}
+
+	data, ok := raw.([]byte)
+	if !ok || json.Unmarshal(data, dest) != nil {
+		metrics.CacheMisses.WithLabelValues(label).Inc()
+		return false
+	}
+
+	metrics.CacheHits.WithLabelValues(label).Inc()
+	return true
+}
+
+// cacheSet stores value under key with the given TTL. A no-op if caching is disabled.
+func cacheSet(ctx context.Context, key string, value interface{}, ttl time.Duration) {
+	if cacheManager == nil {
+		return
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+
+	_ = cacheManager.Set(ctx, key, data, &store.Options{Expiration: ttl})
+}
+
+// cacheInvalidate deletes keys from the cache. A no-op if caching is disabled.
+func cacheInvalidate(ctx context.Context, keys ...string) {
+	if cacheManager == nil {
+		return
+	}
+
+	for _, key := range keys {
+		_ = cacheManager.Delete(ctx, key)
+	}
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/eko/gocache/v2/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCache(t *testing.T) *ristretto.Cache {
+	t.Helper()
+	rc, err := ristretto.NewCache(&ristretto.Config{NumCounters: 1e3, MaxCost: 1 << 20, BufferItems: 64})
+	assert.NoError(t, err)
+	InitCache(store.NewRistretto(rc, nil))
+	return rc
+}
+
+func TestCacheSetGetRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	rc := newTestCache(t)
+
+	cacheSet(ctx, "widget:1", map[string]string{"name": "Widget"}, userTTL)
+	rc.Wait()
+
+	var got map[string]string
+	hit := cacheGet(ctx, "widget", "widget:1", &got)
+
+	assert.True(t, hit)
+	assert.Equal(t, "Widget", got["name"])
+}
+
+func TestCacheGetMissWhenKeyAbsent(t *testing.T) {
+	ctx := context.Background()
+	newTestCache(t)
+
+	var got map[string]string
+	hit := cacheGet(ctx, "widget", "missing-key", &got)
+	assert.False(t, hit)
+}
+
+func TestCacheInvalidateRemovesKey(t *testing.T) {
+	ctx := context.Background()
+	rc := newTestCache(t)
+
+	cacheSet(ctx, "widget:1", map[string]string{"name": "Widget"}, userTTL)
+	rc.Wait()
+
+	cacheInvalidate(ctx, "widget:1")
+
+	var got map[string]string
+	hit := cacheGet(ctx, "widget", "widget:1", &got)
+	assert.False(t, hit)
+}
+
+func TestCacheGetMissWhenDisabled(t *testing.T) {
+	cacheManager = nil
+
+	var got map[string]string
+	hit := cacheGet(context.Background(), "widget", "widget:1", &got)
+	assert.False(t, hit)
+}
@@ -1,38 +1,82 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/example/go-backend/models"
+	"gorm.io/gorm"
 )
 
+// ProductRepository defines the persistence operations for products.
+type ProductRepository interface {
+	GetAllProducts() ([]models.Product, error)
+	GetProductByID(id string) (*models.Product, error)
+	CreateProduct(input *models.CreateProductInput) (*models.Product, error)
+}
+
+// gormProductRepository is a gorm-backed ProductRepository.
+type gormProductRepository struct {
+	db *gorm.DB
+}
+
+// NewProductRepository constructs a gorm-backed ProductRepository.
+func NewProductRepository(db *gorm.DB) ProductRepository {
+	return &gormProductRepository{db: db}
+}
+
+func productKey(id string) string {
+	return "product:" + id
+}
+
 // GetAllProducts retrieves all products from the database
-func GetAllProducts() ([]models.Product, error) {
+func (r *gormProductRepository) GetAllProducts() ([]models.Product, error) {
+	ctx := context.Background()
+
 	var products []models.Product
-	result := db.Find(&products)
+	if cacheGet(ctx, "products", productsAllKey, &products) {
+		return products, nil
+	}
+
+	result := r.db.Find(&products)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+
+	cacheSet(ctx, productsAllKey, products, productsListTTL)
 	return products, nil
 }
 
 // GetProductByID retrieves a product by ID
-func GetProductByID(id string) (*models.Product, error) {
+func (r *gormProductRepository) GetProductByID(id string) (*models.Product, error) {
+	ctx := context.Background()
+	key := productKey(id)
+
 	var product models.Product
-	result := db.First(&product, "id = ?", id)
+	if cacheGet(ctx, "product", key, &product) {
+		return &product, nil
+	}
+
+	result := r.db.First(&product, "id = ?", id)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+
+	cacheSet(ctx, key, product, productTTL)
 	return &product, nil
 }
 
 // CreateProduct creates a new product
-func CreateProduct(input *models.CreateProductInput) (*models.Product, error) {
+func (r *gormProductRepository) CreateProduct(input *models.CreateProductInput) (*models.Product, error) {
 	product := &models.Product{
 		Name:  input.Name,
 		Price: input.Price,
 	}
-	result := db.Create(product)
+	result := r.db.Create(product)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+
+	cacheInvalidate(context.Background(), productsAllKey, productKey(fmt.Sprint(product.ID)))
 	return product, nil
 }
@@ -1,31 +1,81 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/example/go-backend/models"
 	"gorm.io/gorm"
 )
 
-var db *gorm.DB
+// UserRepository defines the persistence operations for users.
+type UserRepository interface {
+	GetAllUsers() ([]models.User, error)
+	GetUserByID(id string) (*models.User, error)
+	GetUserByEmail(email string) (*models.User, error)
+	CreateUser(input *models.CreateUserInput) (*models.User, error)
+	CreateUserWithCredentials(name, email, passwordHash string, scopes []string) (*models.User, error)
+	UpdateUser(id string, input *models.UpdateUserInput) (*models.User, error)
+	DeleteUser(id string) error
+	GetUsersByRole(role string) ([]models.User, error)
+}
 
-// InitDB initializes the database connection
-func InitDB(database *gorm.DB) {
-	db = database
+// gormUserRepository is a gorm-backed UserRepository.
+type gormUserRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository constructs a gorm-backed UserRepository.
+func NewUserRepository(db *gorm.DB) UserRepository {
+	return &gormUserRepository{db: db}
+}
+
+func userKey(id string) string {
+	return "user:" + id
 }
 
 // GetAllUsers retrieves all users from the database
-func GetAllUsers() ([]models.User, error) {
+func (r *gormUserRepository) GetAllUsers() ([]models.User, error) {
+	ctx := context.Background()
+
 	var users []models.User
-	result := db.Find(&users)
+	if cacheGet(ctx, "users", usersAllKey, &users) {
+		return users, nil
+	}
+
+	result := r.db.Find(&users)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+
+	cacheSet(ctx, usersAllKey, users, usersListTTL)
 	return users, nil
 }
 
 // GetUserByID retrieves a user by ID
-func GetUserByID(id string) (*models.User, error) {
+func (r *gormUserRepository) GetUserByID(id string) (*models.User, error) {
+	ctx := context.Background()
+	key := userKey(id)
+
+	var user models.User
+	if cacheGet(ctx, "user", key, &user) {
+		return &user, nil
+	}
+
+	result := r.db.First(&user, "id = ?", id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	cacheSet(ctx, key, user, userTTL)
+	return &user, nil
+}
+
+// GetUserByEmail retrieves a user by email
+func (r *gormUserRepository) GetUserByEmail(email string) (*models.User, error) {
 	var user models.User
-	result := db.First(&user, "id = ?", id)
+	result := r.db.First(&user, "email = ?", email)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -33,46 +83,71 @@ func GetUserByID(id string) (*models.User, error) {
 }
 
 // CreateUser creates a new user in the database
-func CreateUser(input *models.CreateUserInput) (*models.User, error) {
+func (r *gormUserRepository) CreateUser(input *models.CreateUserInput) (*models.User, error) {
 	user := &models.User{
 		Name:  input.Name,
 		Email: input.Email,
 	}
-	result := db.Create(user)
+	result := r.db.Create(user)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	cacheInvalidate(context.Background(), usersAllKey, userKey(fmt.Sprint(user.ID)))
+	return user, nil
+}
+
+// CreateUserWithCredentials creates a new user with a hashed password and scopes
+func (r *gormUserRepository) CreateUserWithCredentials(name, email, passwordHash string, scopes []string) (*models.User, error) {
+	user := &models.User{
+		Name:         name,
+		Email:        email,
+		PasswordHash: passwordHash,
+		Scopes:       strings.Join(scopes, ","),
+	}
+	result := r.db.Create(user)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+
+	cacheInvalidate(context.Background(), usersAllKey, userKey(fmt.Sprint(user.ID)))
 	return user, nil
 }
 
 // UpdateUser updates an existing user
-func UpdateUser(id string, input *models.UpdateUserInput) (*models.User, error) {
+func (r *gormUserRepository) UpdateUser(id string, input *models.UpdateUserInput) (*models.User, error) {
 	var user models.User
-	result := db.First(&user, "id = ?", id)
+	result := r.db.First(&user, "id = ?", id)
 	if result.Error != nil {
 		return nil, result.Error
 	}
-	
-	result = db.Model(&user).Updates(models.User{
+
+	result = r.db.Model(&user).Updates(models.User{
 		Name:  input.Name,
 		Email: input.Email,
 	})
 	if result.Error != nil {
 		return nil, result.Error
 	}
-	
+
+	cacheInvalidate(context.Background(), usersAllKey, userKey(id))
 	return &user, nil
 }
 
 // DeleteUser deletes a user from the database
-func DeleteUser(id string) error {
-	result := db.Delete(&models.User{}, "id = ?", id)
-	return result.Error
+func (r *gormUserRepository) DeleteUser(id string) error {
+	result := r.db.Delete(&models.User{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	cacheInvalidate(context.Background(), usersAllKey, userKey(id))
+	return nil
 }
 
 // GetUsersByRole retrieves users by role using raw SQL
-func GetUsersByRole(role string) ([]models.User, error) {
+func (r *gormUserRepository) GetUsersByRole(role string) ([]models.User, error) {
 	var users []models.User
-	result := db.Raw("SELECT * FROM users WHERE role = ?", role).Scan(&users)
+	result := r.db.Raw("SELECT * FROM users WHERE role = ?", role).Scan(&users)
 	return users, result.Error
 }
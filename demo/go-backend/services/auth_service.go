@@ -0,0 +1,122 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"github.com/example/go-backend/auth"
+	"github.com/example/go-backend/models"
+	"github.com/example/go-backend/repository"
+)
+
+// defaultScopes are granted to users that register through the password flow.
+var defaultScopes = []string{"users:read"}
+
+// ErrInvalidCredentials is returned when login or refresh fails to
+// authenticate the caller.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// TokenPair is the access/refresh token bundle issued on successful auth.
+type TokenPair struct {
+	Access    string
+	Refresh   string
+	ExpiresIn int64
+}
+
+// AuthService handles registration, login, and token issuance/refresh.
+type AuthService struct {
+	users  repository.UserRepository
+	tokens repository.RefreshTokenRepository
+}
+
+// NewAuthService creates a new AuthService backed by users and tokens
+func NewAuthService(users repository.UserRepository, tokens repository.RefreshTokenRepository) *AuthService {
+	return &AuthService{users: users, tokens: tokens}
+}
+
+// Register creates a new user account with a hashed password and issues tokens
+func (s *AuthService) Register(input *models.RegisterInput) (*TokenPair, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.CreateUserWithCredentials(input.Name, input.Email, string(hash), defaultScopes)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(user)
+}
+
+// Login authenticates a user by email/password and issues tokens
+func (s *AuthService) Login(input *models.LoginInput) (*TokenPair, error) {
+	user, err := s.users.GetUserByEmail(input.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(input.Password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.issueTokens(user)
+}
+
+// Refresh exchanges a valid, non-revoked refresh token for a new token pair
+func (s *AuthService) Refresh(refreshToken string) (*TokenPair, error) {
+	stored, err := s.tokens.GetRefreshToken(auth.HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, ErrInvalidCredentials
+	}
+
+	user, err := s.users.GetUserByID(fmt.Sprint(stored.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tokens.RevokeRefreshToken(auth.HashRefreshToken(refreshToken)); err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(user)
+}
+
+// issueTokens mints a fresh access/refresh token pair for user. The refresh
+// token is persisted as its SHA-256 hash, much as passwords are stored only
+// as bcrypt hashes, so a leaked row can't be replayed as a live session.
+func (s *AuthService) issueTokens(user *models.User) (*TokenPair, error) {
+	access, expiresAt, err := auth.GenerateAccessToken(user.ID, user.ScopeList())
+	if err != nil {
+		return nil, err
+	}
+
+	refresh, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.tokens.CreateRefreshToken(user.ID, auth.HashRefreshToken(refresh), time.Now().Add(auth.RefreshTokenTTL)); err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		Access:    access,
+		Refresh:   refresh,
+		ExpiresIn: int64(time.Until(expiresAt).Seconds()),
+	}, nil
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/example/go-backend/models"
+)
+
+// fakeUserRepository is an in-memory repository.UserRepository used to
+// exercise AuthService without a real database.
+type fakeUserRepository struct {
+	users []models.User
+}
+
+func (f *fakeUserRepository) GetAllUsers() ([]models.User, error) { return f.users, nil }
+
+func (f *fakeUserRepository) GetUserByID(id string) (*models.User, error) {
+	for _, u := range f.users {
+		if id == fmt.Sprint(u.ID) {
+			return &u, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (f *fakeUserRepository) GetUserByEmail(email string) (*models.User, error) {
+	for _, u := range f.users {
+		if u.Email == email {
+			return &u, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (f *fakeUserRepository) CreateUser(input *models.CreateUserInput) (*models.User, error) {
+	user := models.User{ID: uint(len(f.users) + 1), Name: input.Name, Email: input.Email}
+	f.users = append(f.users, user)
+	return &user, nil
+}
+
+func (f *fakeUserRepository) CreateUserWithCredentials(name, email, passwordHash string, scopes []string) (*models.User, error) {
+	user := models.User{
+		ID:           uint(len(f.users) + 1),
+		Name:         name,
+		Email:        email,
+		PasswordHash: passwordHash,
+		Scopes:       strings.Join(scopes, ","),
+	}
+	f.users = append(f.users, user)
+	return &user, nil
+}
+
+func (f *fakeUserRepository) UpdateUser(id string, input *models.UpdateUserInput) (*models.User, error) {
+	return f.GetUserByID(id)
+}
+
+func (f *fakeUserRepository) DeleteUser(id string) error { return nil }
+
+func (f *fakeUserRepository) GetUsersByRole(role string) ([]models.User, error) { return f.users, nil }
+
+// fakeRefreshTokenRepository is an in-memory repository.RefreshTokenRepository.
+type fakeRefreshTokenRepository struct {
+	tokens map[string]*models.RefreshToken
+}
+
+func newFakeRefreshTokenRepository() *fakeRefreshTokenRepository {
+	return &fakeRefreshTokenRepository{tokens: make(map[string]*models.RefreshToken)}
+}
+
+func (f *fakeRefreshTokenRepository) CreateRefreshToken(userID uint, tokenHash string, expiresAt time.Time) (*models.RefreshToken, error) {
+	rt := &models.RefreshToken{UserID: userID, Token: tokenHash, ExpiresAt: expiresAt}
+	f.tokens[tokenHash] = rt
+	return rt, nil
+}
+
+func (f *fakeRefreshTokenRepository) GetRefreshToken(tokenHash string) (*models.RefreshToken, error) {
+	rt, ok := f.tokens[tokenHash]
+	if !ok || rt.Revoked {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return rt, nil
+}
+
+func (f *fakeRefreshTokenRepository) RevokeRefreshToken(tokenHash string) error {
+	if rt, ok := f.tokens[tokenHash]; ok {
+		rt.Revoked = true
+	}
+	return nil
+}
+
+func TestAuthServiceRegisterAndLogin(t *testing.T) {
+	svc := NewAuthService(&fakeUserRepository{}, newFakeRefreshTokenRepository())
+
+	tokens, err := svc.Register(&models.RegisterInput{Name: "Ada", Email: "ada@example.com", Password: "hunter22"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tokens.Access)
+	assert.NotEmpty(t, tokens.Refresh)
+
+	loginTokens, err := svc.Login(&models.LoginInput{Email: "ada@example.com", Password: "hunter22"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, loginTokens.Access)
+}
+
+func TestAuthServiceLoginRejectsWrongPassword(t *testing.T) {
+	svc := NewAuthService(&fakeUserRepository{}, newFakeRefreshTokenRepository())
+	_, err := svc.Register(&models.RegisterInput{Name: "Ada", Email: "ada@example.com", Password: "hunter22"})
+	assert.NoError(t, err)
+
+	_, err = svc.Login(&models.LoginInput{Email: "ada@example.com", Password: "wrong-password"})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestAuthServiceRefreshStoresHashNotRawToken(t *testing.T) {
+	tokenRepo := newFakeRefreshTokenRepository()
+	svc := NewAuthService(&fakeUserRepository{}, tokenRepo)
+
+	tokens, err := svc.Register(&models.RegisterInput{Name: "Ada", Email: "ada@example.com", Password: "hunter22"})
+	assert.NoError(t, err)
+
+	// The raw refresh token handed to the caller must not be the persistence key.
+	_, ok := tokenRepo.tokens[tokens.Refresh]
+	assert.False(t, ok, "raw refresh token must not be used as the storage key")
+
+	refreshed, err := svc.Refresh(tokens.Refresh)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, refreshed.Access)
+	assert.NotEqual(t, tokens.Refresh, refreshed.Refresh)
+
+	// The original refresh token is now revoked and can't be reused.
+	_, err = svc.Refresh(tokens.Refresh)
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
@@ -1,60 +1,98 @@
 package services
 
 import (
+	"context"
 	"sync"
 
+	"github.com/example/go-backend/jobs"
 	"github.com/example/go-backend/models"
 	"github.com/example/go-backend/repository"
 )
 
+// processUsersJobName identifies the recurring "process all users" job.
+const processUsersJobName = "process_users"
+
+// sendNotificationJobName identifies the per-user notification job.
+const sendNotificationJobName = "send_notification"
+
 // UserService handles user business logic
 type UserService struct {
-	mu sync.Mutex
+	repo      repository.UserRepository
+	scheduler *jobs.Scheduler
+	mu        sync.Mutex
 }
 
-// NewUserService creates a new UserService
-func NewUserService() *UserService {
-	return &UserService{}
+// NewUserService creates a new UserService backed by repo, enqueuing
+// background work onto scheduler
+func NewUserService(repo repository.UserRepository, scheduler *jobs.Scheduler) *UserService {
+	return &UserService{repo: repo, scheduler: scheduler}
 }
 
-// ProcessUsers processes users in background
-func (s *UserService) ProcessUsers() {
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				// Handle panic
+// ListUsers returns all users
+func (s *UserService) ListUsers() ([]models.User, error) {
+	return s.repo.GetAllUsers()
+}
+
+// GetUser returns a single user by ID
+func (s *UserService) GetUser(id string) (*models.User, error) {
+	return s.repo.GetUserByID(id)
+}
+
+// CreateUser creates a new user
+func (s *UserService) CreateUser(input *models.CreateUserInput) (*models.User, error) {
+	return s.repo.CreateUser(input)
+}
+
+// UpdateUser updates an existing user
+func (s *UserService) UpdateUser(id string, input *models.UpdateUserInput) (*models.User, error) {
+	return s.repo.UpdateUser(id, input)
+}
+
+// DeleteUser deletes a user
+func (s *UserService) DeleteUser(id string) error {
+	return s.repo.DeleteUser(id)
+}
+
+// ProcessUsersJob returns the background job definition that processes all users.
+func (s *UserService) ProcessUsersJob() jobs.Job {
+	return jobs.Job{
+		Name: processUsersJobName,
+		Handler: func(ctx context.Context, _ interface{}) error {
+			users, err := s.repo.GetAllUsers()
+			if err != nil {
+				return err
+			}
+
+			for _, user := range users {
+				s.processUser(user)
 			}
-		}()
-		
-		users, err := repository.GetAllUsers()
-		if err != nil {
-			return
-		}
-		
-		for _, user := range users {
-			s.processUser(user)
-		}
-	}()
+			return nil
+		},
+	}
+}
+
+// ProcessUsers enqueues a background job that processes all users.
+func (s *UserService) ProcessUsers() {
+	s.scheduler.Enqueue(s.ProcessUsersJob())
 }
 
 func (s *UserService) processUser(user models.User) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	
+
 	// Process user
 }
 
-// SendNotifications sends notifications to users
+// SendNotifications enqueues a background job per user ID to send a notification.
 func (s *UserService) SendNotifications(userIDs []string) {
-	var wg sync.WaitGroup
-	
 	for _, id := range userIDs {
-		wg.Add(1)
-		go func(userID string) {
-			defer wg.Done()
-			// Send notification
-		}(id)
+		s.scheduler.Enqueue(jobs.Job{
+			Name:    sendNotificationJobName,
+			Payload: id,
+			Handler: func(ctx context.Context, payload interface{}) error {
+				// Send notification
+				return nil
+			},
+		})
 	}
-	
-	wg.Wait()
 }
@@ -0,0 +1,21 @@
+// Package types holds the request/response DTOs exchanged over the HTTP
+// boundary, kept separate from the gorm-backed models in package models.
+package types
+
+// CreateUserRequest is the request body for creating a user.
+type CreateUserRequest struct {
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+// UpdateUserRequest is the request body for updating a user.
+type UpdateUserRequest struct {
+	Name  string `json:"name" validate:"omitempty"`
+	Email string `json:"email" validate:"omitempty,email"`
+}
+
+// CreateProductRequest is the request body for creating a product.
+type CreateProductRequest struct {
+	Name  string  `json:"name" validate:"required"`
+	Price float64 `json:"price" validate:"required,price_positive"`
+}
@@ -0,0 +1,10 @@
+package types
+
+// ErrorResponse is the typed error payload handlers return when request
+// validation or processing fails.
+type ErrorResponse struct {
+	Success bool              `json:"success"`
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
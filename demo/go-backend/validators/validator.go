@@ -0,0 +1,70 @@
+// Package validators wraps go-playground/validator with English translators
+// so handlers can turn binding failures into per-field error messages
+// instead of surfacing raw validator output.
+package validators
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+
+	"github.com/example/go-backend/types"
+)
+
+var (
+	validate *validator.Validate
+	trans    ut.Translator
+)
+
+func init() {
+	english := en.New()
+	uni := ut.New(english, english)
+	trans, _ = uni.GetTranslator("en")
+
+	validate = validator.New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	_ = en_translations.RegisterDefaultTranslations(validate, trans)
+}
+
+// RegisterCustom registers a custom validation tag with its validation function.
+func RegisterCustom(tag string, fn validator.Func) error {
+	return validate.RegisterValidation(tag, fn)
+}
+
+// PricePositive is a custom validator enforcing that a field is > 0, used
+// for Product.Price via the "price_positive" tag.
+func PricePositive(fl validator.FieldLevel) bool {
+	return fl.Field().Float() > 0
+}
+
+// ValidateStruct validates i and, on failure, returns a populated
+// types.ErrorResponse with a per-field message map. Returns nil on success.
+func ValidateStruct(i interface{}) *types.ErrorResponse {
+	err := validate.Struct(i)
+	if err == nil {
+		return nil
+	}
+
+	fields := make(map[string]string)
+	for _, fe := range err.(validator.ValidationErrors) {
+		fields[fe.Field()] = fe.Translate(trans)
+	}
+
+	return &types.ErrorResponse{
+		Success: false,
+		Code:    "validation_failed",
+		Message: "one or more fields are invalid",
+		Fields:  fields,
+	}
+}
@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/example/go-backend/types"
+)
+
+// TestMain registers the "price_positive" tag the way cmd/app.Run does at
+// startup, since ValidateStruct otherwise has no validator registered for it.
+func TestMain(m *testing.M) {
+	if err := RegisterCustom("price_positive", PricePositive); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestValidateStructPassesValidInput(t *testing.T) {
+	errResp := ValidateStruct(types.CreateUserRequest{Name: "Ada", Email: "ada@example.com"})
+	assert.Nil(t, errResp)
+}
+
+func TestValidateStructReportsFieldErrorsByJSONName(t *testing.T) {
+	errResp := ValidateStruct(types.CreateUserRequest{Name: "", Email: "not-an-email"})
+	assert.NotNil(t, errResp)
+	assert.Equal(t, "validation_failed", errResp.Code)
+	assert.Contains(t, errResp.Fields, "name")
+	assert.Contains(t, errResp.Fields, "email")
+}
+
+func TestPricePositiveCustomTag(t *testing.T) {
+	assert.Nil(t, ValidateStruct(types.CreateProductRequest{Name: "Widget", Price: 9.99}))
+
+	errResp := ValidateStruct(types.CreateProductRequest{Name: "Widget", Price: 0})
+	assert.NotNil(t, errResp)
+	assert.Contains(t, errResp.Fields, "price")
+
+	// A zero price is already rejected by "required"; a negative one is
+	// non-zero, so only the custom tag can reject it.
+	errResp = ValidateStruct(types.CreateProductRequest{Name: "Widget", Price: -1})
+	assert.NotNil(t, errResp)
+	assert.Contains(t, errResp.Fields, "price")
+}